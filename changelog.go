@@ -0,0 +1,63 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Changelog groups the FeatureChanges between two configs by language, for
+// generating per-language release notes.
+type Changelog struct {
+	Languages map[string][]FeatureChange
+}
+
+// Changelog computes the per-language, per-feature version changes between
+// prev and c, grouping them for release notes. prev is treated as the older
+// config, c as the newer one.
+func (c *Config) Changelog(prev *Config) *Changelog {
+	changes := prev.FeatureVersions().Diff(c.FeatureVersions())
+
+	cl := &Changelog{Languages: map[string][]FeatureChange{}}
+	for _, change := range changes {
+		cl.Languages[change.Language] = append(cl.Languages[change.Language], change)
+	}
+
+	for _, changes := range cl.Languages {
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].Feature < changes[j].Feature
+		})
+	}
+
+	return cl
+}
+
+// Markdown renders the changelog as a per-language list of feature version
+// changes, suitable for inclusion in release notes.
+func (cl *Changelog) Markdown() string {
+	languages := make([]string, 0, len(cl.Languages))
+	for lang := range cl.Languages {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+
+	var b strings.Builder
+	for _, lang := range languages {
+		fmt.Fprintf(&b, "## %s\n\n", lang)
+
+		for _, change := range cl.Languages[lang] {
+			switch change.Kind {
+			case FeatureAdded:
+				fmt.Fprintf(&b, "- Added `%s` at %s\n", change.Feature, change.To)
+			case FeatureRemoved:
+				fmt.Fprintf(&b, "- Removed `%s` (was %s)\n", change.Feature, change.From)
+			case FeatureUpgraded:
+				fmt.Fprintf(&b, "- Upgraded `%s` from %s to %s\n", change.Feature, change.From, change.To)
+			}
+		}
+
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Changelog(t *testing.T) {
+	prev := &Config{
+		Features: map[string]map[string]string{
+			"go": {
+				"core":  "2.90.0",
+				"oauth": "1.0.0",
+			},
+		},
+	}
+	cur := &Config{
+		Features: map[string]map[string]string{
+			"go": {
+				"core": "2.91.0",
+				"sse":  "1.0.0",
+			},
+		},
+	}
+
+	cl := cur.Changelog(prev)
+
+	assert.Equal(t, "## go\n\n- Upgraded `core` from 2.90.0 to 2.91.0\n- Removed `oauth` (was 1.0.0)\n- Added `sse` at 1.0.0\n", cl.Markdown())
+}
@@ -0,0 +1,66 @@
+package config
+
+import "gopkg.in/yaml.v3"
+
+// Config is the root of a gen.yaml file: the per-language generation state
+// tracked across SDK generation runs.
+type Config struct {
+	ConfigVersion string                       `yaml:"configVersion"`
+	Management    *Management                  `yaml:"management,omitempty"`
+	Languages     map[string]LanguageConfig    `yaml:"languages"`
+	Generation    Generation                   `yaml:"generation"`
+	Features      map[string]map[string]string `yaml:"features"`
+
+	// New holds the languages added by this Load call that weren't already
+	// present in the loaded file. It isn't persisted.
+	New map[string]bool `yaml:"-"`
+
+	// node is the node tree this Config was decoded from, if it was loaded
+	// from a file, so Save can patch it in place instead of rewriting it
+	// wholesale. It's unexported rather than cached in a side map keyed by
+	// *Config, so its lifetime is simply the Config's own - no separate
+	// cache entry can outlive (or fail to outlive) the Config it describes.
+	// Tests that compare a loaded Config against a hand-built one use
+	// assert.EqualExportedValues, which ignores this field, instead of
+	// assert.Equal.
+	node *yaml.Node
+
+	// migrationHistory records the migrations applied the last time this
+	// Config was loaded, for MigrationHistory. See node for why it's an
+	// unexported field rather than a side cache.
+	migrationHistory []MigrationStep
+}
+
+// Management records metadata about the last managed generation run, such
+// as the source document and generator versions involved.
+type Management struct {
+	DocChecksum      string `yaml:"docChecksum"`
+	DocVersion       string `yaml:"docVersion"`
+	SpeakeasyVersion string `yaml:"speakeasyVersion"`
+}
+
+// LanguageConfig is the per-language section of a gen.yaml file. Cfg holds
+// whatever language-specific keys the generator for that language reads,
+// e.g. packageName for Go.
+type LanguageConfig struct {
+	Version string         `yaml:"version"`
+	Cfg     map[string]any `yaml:",inline"`
+}
+
+// Generation holds the options that control how SDKs are generated,
+// independent of any single language.
+type Generation struct {
+	BaseServerURL          string    `yaml:"baseServerURL,omitempty"`
+	SDKClassName           string    `yaml:"sdkClassName"`
+	SingleTagPerOp         bool      `yaml:"singleTagPerOp"`
+	TagNamespacingDisabled bool      `yaml:"tagNamespacingDisabled"`
+	MaintainOpenAPIOrder   bool      `yaml:"maintainOpenAPIOrder"`
+	Comments               *Comments `yaml:"comments,omitempty"`
+}
+
+// Comments controls how OpenAPI descriptions are rendered as comments in
+// generated code.
+type Comments struct {
+	DisableComments                 bool `yaml:"disableComments"`
+	OmitDescriptionIfSummaryPresent bool `yaml:"omitDescriptionIfSummaryPresent"`
+}
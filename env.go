@@ -0,0 +1,82 @@
+package config
+
+import (
+	"regexp"
+	"strings"
+)
+
+// envVarPattern matches ${ENV_VAR} and ${ENV_VAR:-default} references.
+var envVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// expandConfigEnv resolves environment variable references in the string
+// values of cfg's Management, Generation, each language's Version and Cfg,
+// and Features, mutating cfg in place; ConfigVersion is left as is. It's
+// applied to the Config returned from Load, after that Config (with its
+// references still intact) has already been persisted, so Load never
+// writes the resolved values to gen.yaml.
+func expandConfigEnv(cfg *Config, lookup EnvLookupFunc) {
+	if cfg.Management != nil {
+		cfg.Management.DocChecksum = expandEnvValue(cfg.Management.DocChecksum, lookup)
+		cfg.Management.DocVersion = expandEnvValue(cfg.Management.DocVersion, lookup)
+		cfg.Management.SpeakeasyVersion = expandEnvValue(cfg.Management.SpeakeasyVersion, lookup)
+	}
+
+	cfg.Generation.BaseServerURL = expandEnvValue(cfg.Generation.BaseServerURL, lookup)
+	cfg.Generation.SDKClassName = expandEnvValue(cfg.Generation.SDKClassName, lookup)
+
+	for lang, langCfg := range cfg.Languages {
+		langCfg.Version = expandEnvValue(langCfg.Version, lookup)
+		if langCfg.Cfg != nil {
+			langCfg.Cfg = expandAnyEnv(langCfg.Cfg, lookup).(map[string]any)
+		}
+		cfg.Languages[lang] = langCfg
+	}
+
+	for lang, features := range cfg.Features {
+		for feature, version := range features {
+			features[feature] = expandEnvValue(version, lookup)
+		}
+		cfg.Features[lang] = features
+	}
+}
+
+// expandAnyEnv recursively expands string values nested in maps and
+// slices, mirroring the shapes a LanguageConfig.Cfg value can take once
+// decoded from YAML.
+func expandAnyEnv(v any, lookup EnvLookupFunc) any {
+	switch val := v.(type) {
+	case string:
+		return expandEnvValue(val, lookup)
+	case map[string]any:
+		for k, nested := range val {
+			val[k] = expandAnyEnv(nested, lookup)
+		}
+		return val
+	case []any:
+		for i, nested := range val {
+			val[i] = expandAnyEnv(nested, lookup)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// expandEnvValue resolves env var references within a single string value.
+func expandEnvValue(s string, lookup EnvLookupFunc) string {
+	if name, ok := strings.CutPrefix(s, "$ENV_"); ok {
+		if v, ok := lookup(name); ok {
+			return v
+		}
+		return s
+	}
+
+	return envVarPattern.ReplaceAllStringFunc(s, func(match string) string {
+		groups := envVarPattern.FindStringSubmatch(match)
+		name, def := groups[1], groups[3]
+		if v, ok := lookup(name); ok {
+			return v
+		}
+		return def
+	})
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// BumpKind selects which part of a semantic version FeatureVersions.Bump
+// increments.
+type BumpKind string
+
+const (
+	BumpMajor BumpKind = "major"
+	BumpMinor BumpKind = "minor"
+	BumpPatch BumpKind = "patch"
+)
+
+// FeatureVersions is a typed view over a language -> feature -> version map,
+// the same shape Config.Features persists to gen.yaml.
+type FeatureVersions struct {
+	features map[string]map[string]string
+}
+
+// NewFeatureVersions wraps a Config.Features map for typed access. A nil map
+// is treated as empty.
+func NewFeatureVersions(features map[string]map[string]string) *FeatureVersions {
+	if features == nil {
+		features = map[string]map[string]string{}
+	}
+	return &FeatureVersions{features: features}
+}
+
+// FeatureVersions returns a typed view over c.Features.
+func (c *Config) FeatureVersions() *FeatureVersions {
+	return NewFeatureVersions(c.Features)
+}
+
+// Get returns the parsed version recorded for lang/feature, and whether one
+// was present and parsed as a semantic version.
+func (f *FeatureVersions) Get(lang, feature string) (*semver.Version, bool) {
+	raw, ok := f.features[lang][feature]
+	if !ok {
+		return nil, false
+	}
+
+	v, err := semver.NewVersion(raw)
+	if err != nil {
+		return nil, false
+	}
+
+	return v, true
+}
+
+// Set records version for lang/feature, creating the language's feature map
+// if necessary.
+func (f *FeatureVersions) Set(lang, feature string, version *semver.Version) {
+	if f.features[lang] == nil {
+		f.features[lang] = map[string]string{}
+	}
+	f.features[lang][feature] = version.String()
+}
+
+// Bump increments the recorded version for lang/feature by kind and stores
+// the result. A feature with no prior version starts from 0.0.0.
+func (f *FeatureVersions) Bump(lang, feature string, kind BumpKind) (*semver.Version, error) {
+	current, ok := f.Get(lang, feature)
+	if !ok {
+		current = semver.MustParse("0.0.0")
+	}
+
+	var next semver.Version
+	switch kind {
+	case BumpMajor:
+		next = current.IncMajor()
+	case BumpMinor:
+		next = current.IncMinor()
+	case BumpPatch:
+		next = current.IncPatch()
+	default:
+		return nil, fmt.Errorf("unknown bump kind %q", kind)
+	}
+
+	f.Set(lang, feature, &next)
+
+	return &next, nil
+}
+
+// FeatureChangeKind describes how a feature's version moved between two
+// FeatureVersions snapshots.
+type FeatureChangeKind string
+
+const (
+	FeatureAdded    FeatureChangeKind = "added"
+	FeatureRemoved  FeatureChangeKind = "removed"
+	FeatureUpgraded FeatureChangeKind = "upgraded"
+)
+
+// FeatureChange is one language/feature's movement between two
+// FeatureVersions snapshots.
+type FeatureChange struct {
+	Language string
+	Feature  string
+	Kind     FeatureChangeKind
+	From     *semver.Version
+	To       *semver.Version
+}
+
+// Diff reports the additions, removals, and upgrades needed to go from f to
+// other. Downgrades are reported as upgrades from the caller's point of
+// view; callers comparing in the other direction should swap the receiver.
+func (f *FeatureVersions) Diff(other *FeatureVersions) []FeatureChange {
+	var changes []FeatureChange
+
+	for lang, features := range f.features {
+		for feature, rawFrom := range features {
+			from, _ := semver.NewVersion(rawFrom)
+
+			to, ok := other.Get(lang, feature)
+			if !ok {
+				changes = append(changes, FeatureChange{
+					Language: lang,
+					Feature:  feature,
+					Kind:     FeatureRemoved,
+					From:     from,
+				})
+				continue
+			}
+
+			if !to.Equal(from) {
+				changes = append(changes, FeatureChange{
+					Language: lang,
+					Feature:  feature,
+					Kind:     FeatureUpgraded,
+					From:     from,
+					To:       to,
+				})
+			}
+		}
+	}
+
+	for lang, features := range other.features {
+		for feature, raw := range features {
+			if _, ok := f.features[lang][feature]; ok {
+				continue
+			}
+
+			to, _ := semver.NewVersion(raw)
+			changes = append(changes, FeatureChange{
+				Language: lang,
+				Feature:  feature,
+				Kind:     FeatureAdded,
+				To:       to,
+			})
+		}
+	}
+
+	return changes
+}
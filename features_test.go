@@ -0,0 +1,80 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureVersions_GetSet(t *testing.T) {
+	f := NewFeatureVersions(nil)
+
+	_, ok := f.Get("go", "core")
+	assert.False(t, ok)
+
+	f.Set("go", "core", semver.MustParse("2.90.0"))
+
+	v, ok := f.Get("go", "core")
+	require.True(t, ok)
+	assert.Equal(t, "2.90.0", v.String())
+}
+
+func TestFeatureVersions_Bump(t *testing.T) {
+	tests := []struct {
+		name string
+		kind BumpKind
+		from string
+		want string
+	}{
+		{name: "major", kind: BumpMajor, from: "2.90.0", want: "3.0.0"},
+		{name: "minor", kind: BumpMinor, from: "2.90.0", want: "2.91.0"},
+		{name: "patch", kind: BumpPatch, from: "2.90.0", want: "2.90.1"},
+		{name: "starts from 0.0.0 when unset", kind: BumpPatch, from: "", want: "0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFeatureVersions(nil)
+			if tt.from != "" {
+				f.Set("go", "core", semver.MustParse(tt.from))
+			}
+
+			got, err := f.Bump("go", "core", tt.kind)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+
+			v, ok := f.Get("go", "core")
+			require.True(t, ok)
+			assert.Equal(t, tt.want, v.String())
+		})
+	}
+}
+
+func TestFeatureVersions_Diff(t *testing.T) {
+	prev := NewFeatureVersions(map[string]map[string]string{
+		"go": {
+			"core":  "2.90.0",
+			"oauth": "1.0.0",
+		},
+	})
+	next := NewFeatureVersions(map[string]map[string]string{
+		"go": {
+			"core": "2.91.0",
+			"sse":  "1.0.0",
+		},
+	})
+
+	changes := prev.Diff(next)
+
+	assert.Len(t, changes, 3)
+
+	var kinds []FeatureChangeKind
+	for _, c := range changes {
+		kinds = append(kinds, c.Kind)
+	}
+	assert.Contains(t, kinds, FeatureUpgraded)
+	assert.Contains(t, kinds, FeatureRemoved)
+	assert.Contains(t, kinds, FeatureAdded)
+}
@@ -0,0 +1,9 @@
+package config
+
+// testUpdateLang is the UpgradeFunc used in tests to migrate a pre-v1.0.0
+// gen.yaml. Real pre-v1.0.0 files only lacked the configVersion key, so
+// upgrading is just stamping the current Version onto them.
+func testUpdateLang(raw map[string]any) (map[string]any, error) {
+	raw["configVersion"] = Version
+	return raw, nil
+}
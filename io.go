@@ -0,0 +1,227 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Load reads the gen.yaml nearest to dir, walking up through its parent
+// directories, and returns the resulting Config. If no gen.yaml is found, a
+// new one is created in dir. Languages named via WithLanguages that aren't
+// already present are added with defaultLanguageVersion and recorded in
+// Config.New. A file that predates the current Version is walked through
+// the registered migration chain; the steps taken are available from
+// Config.MigrationHistory. The (possibly new or migrated) config is
+// written back to disk before Load returns, unless WithDryRun is enabled.
+func Load(dir string, opts ...Option) (*Config, error) {
+	o := newLoadOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	foundDir, data, err := findConfigFile(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	targetDir := dir
+
+	var cfg *Config
+	if data == nil {
+		cfg = newConfig(o.languages)
+	} else {
+		targetDir = foundDir
+
+		cfg, err = parseConfig(data, o)
+		if err != nil {
+			return nil, err
+		}
+
+		applyLanguages(cfg, o.languages)
+	}
+
+	if err := validateLanguages(cfg, o.languageSchemas); err != nil {
+		return nil, fmt.Errorf("validating %s: %w", configFileName, err)
+	}
+
+	if !o.dryRun {
+		if err := cfg.Save(targetDir); err != nil {
+			return nil, err
+		}
+	}
+
+	// Env expansion happens on the Config returned to the caller only, after
+	// it's already been persisted (or would have been, under WithDryRun), so
+	// Load never resolves a gen.yaml's $ENV_ and ${...} references on disk.
+	if o.envExpansion {
+		expandConfigEnv(cfg, o.envLookup)
+	}
+
+	return cfg, nil
+}
+
+// validateLanguages runs each language in cfg through its registered
+// schema, if any, applying defaults and collecting every violation rather
+// than stopping at the first. A language Load has just scaffolded (present
+// in cfg.New) has its required fields relaxed, since the user hasn't had a
+// chance to configure it yet.
+func validateLanguages(cfg *Config, overrides map[string]LanguageSchema) error {
+	var errs []error
+
+	for lang, langCfg := range cfg.Languages {
+		schema, ok := lookupSchema(lang, overrides)
+		if !ok {
+			continue
+		}
+
+		if cfg.New[lang] {
+			schema = schema.withoutRequired()
+		}
+
+		newCfg, fieldErrs := schema.Apply(lang, langCfg.Cfg)
+		langCfg.Cfg = newCfg
+		cfg.Languages[lang] = langCfg
+
+		errs = append(errs, fieldErrs...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// findConfigFile walks up from dir looking for a gen.yaml, returning the
+// directory it was found in and its contents. If none is found by the time
+// the filesystem root is reached, it returns a nil data with no error.
+func findConfigFile(dir string) (string, []byte, error) {
+	current := dir
+
+	for {
+		path := filepath.Join(current, configFileName)
+
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			return current, data, nil
+		case !os.IsNotExist(err):
+			return "", nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			return "", nil, nil
+		}
+		current = parent
+	}
+}
+
+// newConfig builds the default Config written the first time a directory is
+// generated into, with every requested language marked as new.
+func newConfig(languages []string) *Config {
+	cfg := &Config{
+		ConfigVersion: Version,
+		Languages:     map[string]LanguageConfig{},
+		Generation: Generation{
+			SDKClassName:         "SDK",
+			MaintainOpenAPIOrder: true,
+			Comments:             &Comments{},
+		},
+		Features: map[string]map[string]string{},
+		New:      map[string]bool{},
+	}
+
+	for _, lang := range languages {
+		cfg.Languages[lang] = LanguageConfig{Version: defaultLanguageVersion}
+		cfg.New[lang] = true
+	}
+
+	return cfg
+}
+
+// parseConfig decodes a gen.yaml's contents into a Config, walking it
+// through the registered migration chain if it's behind the current
+// Version. The node tree backing the file is kept on the returned Config
+// so a later Save can patch it in place instead of rewriting it wholesale.
+// Env expansion, if requested, is applied separately by the caller once
+// this Config has been persisted.
+func parseConfig(data []byte, o *loadOptions) (*Config, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", configFileName, err)
+	}
+
+	root := documentRoot(&doc)
+
+	var raw map[string]any
+	if err := root.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", configFileName, err)
+	}
+
+	current, _ := raw["configVersion"].(string)
+
+	var history []MigrationStep
+	if current != Version {
+		migrated, steps, err := runMigrations(raw, current, Version, migrationChain(o.migrations))
+		if err != nil {
+			return nil, fmt.Errorf("migrating %s: %w", configFileName, err)
+		}
+
+		desired := &yaml.Node{}
+		if err := desired.Encode(migrated); err != nil {
+			return nil, fmt.Errorf("migrating %s: %w", configFileName, err)
+		}
+		syncNode(root, desired)
+		history = steps
+	}
+
+	cfg := &Config{}
+	if err := root.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", configFileName, err)
+	}
+
+	if cfg.Features == nil {
+		cfg.Features = map[string]map[string]string{}
+	}
+	if cfg.New == nil {
+		cfg.New = map[string]bool{}
+	}
+
+	cfg.node = root
+	cfg.migrationHistory = history
+
+	return cfg, nil
+}
+
+// documentRoot returns the mapping node at the top of a decoded document,
+// tolerating an empty document (e.g. a zero-byte gen.yaml).
+func documentRoot(doc *yaml.Node) *yaml.Node {
+	if doc.Kind != yaml.DocumentNode {
+		return doc
+	}
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode}
+	}
+	return doc.Content[0]
+}
+
+// applyLanguages adds any requested language missing from cfg, marking it
+// as new.
+func applyLanguages(cfg *Config, languages []string) {
+	if cfg.Languages == nil {
+		cfg.Languages = map[string]LanguageConfig{}
+	}
+	if cfg.New == nil {
+		cfg.New = map[string]bool{}
+	}
+
+	for _, lang := range languages {
+		if _, ok := cfg.Languages[lang]; ok {
+			continue
+		}
+
+		cfg.Languages[lang] = LanguageConfig{Version: defaultLanguageVersion}
+		cfg.New[lang] = true
+	}
+}
@@ -230,13 +230,100 @@ func TestLoad_Success(t *testing.T) {
 
 			cfg, err := Load(filepath.Join(os.TempDir(), testDir), opts...)
 			assert.NoError(t, err)
-			assert.Equal(t, tt.want, cfg)
+			assert.EqualExportedValues(t, tt.want, cfg)
 			_, err = os.Stat(filepath.Join(dir, "gen.yaml"))
 			assert.NoError(t, err)
 		})
 	}
 }
 
+func TestLoad_EnvExpansion(t *testing.T) {
+	type args struct {
+		langs   []string
+		dir     string
+		genYaml string
+	}
+	tests := []struct {
+		name string
+		args args
+		env  map[string]string
+		want *Config
+	}{
+		{
+			name: "expands ${ENV_VAR:-default} fallbacks and $ENV_ references",
+			args: args{
+				langs:   []string{"go"},
+				dir:     testDir,
+				genYaml: readTestFile(t, "env-gen.yaml"),
+			},
+			env: map[string]string{
+				"SDK_CLASS_NAME": "speakeasy",
+			},
+			want: &Config{
+				ConfigVersion: Version,
+				Management: &Management{
+					DocChecksum:      "2bba3b8f9d211b02569b3f9aff0d34b4",
+					DocVersion:       "0.3.0",
+					SpeakeasyVersion: "1.3.1",
+				},
+				Languages: map[string]LanguageConfig{
+					"go": {
+						Version: "1.3.0",
+						Cfg: map[string]any{
+							"packageName": "github.com/speakeasy-api/speakeasy-client-sdk-go",
+						},
+					},
+				},
+				Generation: Generation{
+					BaseServerURL: "https://api.staging.speakeasyapi.dev",
+					SDKClassName:  "speakeasy",
+					Comments: &Comments{
+						DisableComments:                 false,
+						OmitDescriptionIfSummaryPresent: true,
+					},
+				},
+				Features: map[string]map[string]string{},
+				New:      map[string]bool{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(os.TempDir(), tt.args.dir)
+
+			err := createTempFile(tt.args.dir, tt.args.genYaml)
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			lookup := func(name string) (string, bool) {
+				v, ok := tt.env[name]
+				return v, ok
+			}
+
+			opts := []Option{
+				WithUpgradeFunc(testUpdateLang),
+				WithEnvExpansion(true),
+				WithEnvLookup(lookup),
+			}
+
+			for _, lang := range tt.args.langs {
+				opts = append(opts, WithLanguages(lang))
+			}
+
+			cfg, err := Load(filepath.Join(os.TempDir(), testDir), opts...)
+			assert.NoError(t, err)
+			assert.EqualExportedValues(t, tt.want, cfg)
+
+			saved, err := os.ReadFile(filepath.Join(dir, "gen.yaml"))
+			require.NoError(t, err)
+			assert.Contains(t, string(saved), "${ENV_BASE_URL:-https://api.staging.speakeasyapi.dev}",
+				"env references should not be resolved in the persisted gen.yaml")
+			assert.Contains(t, string(saved), "$ENV_SDK_CLASS_NAME",
+				"env references should not be resolved in the persisted gen.yaml")
+		})
+	}
+}
+
 func createTempFile(dir string, contents string) error {
 	tmpDir := filepath.Join(os.TempDir(), dir)
 
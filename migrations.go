@@ -0,0 +1,71 @@
+package config
+
+import "fmt"
+
+// Migration transforms a raw gen.yaml (decoded as a map) from one
+// configVersion to the next in a single step.
+type Migration struct {
+	From  string
+	To    string
+	Apply UpgradeFunc
+}
+
+// MigrationStep records one migration applied while loading a Config, for
+// Config.MigrationHistory.
+type MigrationStep struct {
+	From string
+	To   string
+}
+
+// defaultMigrations is the chain built into this module. Migrations added
+// with WithMigrations or WithUpgradeFunc are merged over it, replacing any
+// entry with the same From. Its only entry upgrades a pre-v1.0.0 file - one
+// with no configVersion key at all - to the current Version.
+var defaultMigrations = []Migration{
+	{From: "", To: Version, Apply: defaultUpgradeFunc},
+}
+
+// MigrationHistory returns the migrations applied the last time c was
+// loaded, in the order they ran. It's nil if c didn't need migrating.
+func (c *Config) MigrationHistory() []MigrationStep {
+	return c.migrationHistory
+}
+
+// migrationChain merges custom migrations over the built-in defaults, keyed
+// by their From version so a caller can override any step, including the
+// default one.
+func migrationChain(custom []Migration) map[string]Migration {
+	chain := make(map[string]Migration, len(defaultMigrations)+len(custom))
+	for _, m := range defaultMigrations {
+		chain[m.From] = m
+	}
+	for _, m := range custom {
+		chain[m.From] = m
+	}
+	return chain
+}
+
+// runMigrations walks chain from current to target, applying each step's
+// Apply func in turn, and returns the migrated raw config along with the
+// ordered steps taken.
+func runMigrations(raw map[string]any, current, target string, chain map[string]Migration) (map[string]any, []MigrationStep, error) {
+	var history []MigrationStep
+
+	for current != target {
+		m, ok := chain[current]
+		if !ok {
+			return nil, nil, fmt.Errorf("no migration registered from configVersion %q toward %q", current, target)
+		}
+
+		next, err := m.Apply(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("migrating configVersion %q to %q: %w", m.From, m.To, err)
+		}
+
+		raw = next
+		history = append(history, MigrationStep{From: m.From, To: m.To})
+		current = m.To
+	}
+
+	return raw, history, nil
+}
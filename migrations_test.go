@@ -0,0 +1,150 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMigrations_Chain(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		chain   []Migration
+		want    map[string]any
+		history []MigrationStep
+		wantErr string
+	}{
+		{
+			name:    "no migration needed",
+			current: Version,
+			chain:   nil,
+			want:    map[string]any{"configVersion": Version},
+		},
+		{
+			name:    "single step",
+			current: "",
+			chain: []Migration{
+				{From: "", To: Version, Apply: func(raw map[string]any) (map[string]any, error) {
+					raw["configVersion"] = Version
+					return raw, nil
+				}},
+			},
+			want:    map[string]any{"configVersion": Version},
+			history: []MigrationStep{{From: "", To: Version}},
+		},
+		{
+			name:    "multi step chain through synthetic versions",
+			current: "0.1.0",
+			chain: []Migration{
+				{From: "0.1.0", To: "0.2.0", Apply: func(raw map[string]any) (map[string]any, error) {
+					raw["configVersion"] = "0.2.0"
+					raw["stepOne"] = true
+					return raw, nil
+				}},
+				{From: "0.2.0", To: "0.3.0", Apply: func(raw map[string]any) (map[string]any, error) {
+					raw["configVersion"] = "0.3.0"
+					raw["stepTwo"] = true
+					return raw, nil
+				}},
+				{From: "0.3.0", To: Version, Apply: func(raw map[string]any) (map[string]any, error) {
+					raw["configVersion"] = Version
+					return raw, nil
+				}},
+			},
+			want: map[string]any{
+				"configVersion": Version,
+				"stepOne":       true,
+				"stepTwo":       true,
+			},
+			history: []MigrationStep{
+				{From: "0.1.0", To: "0.2.0"},
+				{From: "0.2.0", To: "0.3.0"},
+				{From: "0.3.0", To: Version},
+			},
+		},
+		{
+			name:    "missing step in chain",
+			current: "0.1.0",
+			chain: []Migration{
+				{From: "0.2.0", To: Version, Apply: func(raw map[string]any) (map[string]any, error) {
+					return raw, nil
+				}},
+			},
+			wantErr: `no migration registered from configVersion "0.1.0" toward "1.0.0"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]any{}
+			if tt.current != "" {
+				raw["configVersion"] = tt.current
+			}
+
+			got, history, err := runMigrations(raw, tt.current, Version, migrationChain(tt.chain))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.history, history)
+		})
+	}
+}
+
+func TestLoad_WithMigrations_SyntheticChain(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), testDir)
+
+	err := createTempFile(testDir, readTestFile(t, "synthetic-v010-gen.yaml"))
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg, err := Load(dir,
+		WithLanguages("go"),
+		WithMigrations(
+			Migration{From: "0.1.0", To: "0.2.0", Apply: func(raw map[string]any) (map[string]any, error) {
+				raw["configVersion"] = "0.2.0"
+				raw["generation"].(map[string]any)["sdkClassName"] = "speakeasy"
+				return raw, nil
+			}},
+			Migration{From: "0.2.0", To: Version, Apply: func(raw map[string]any) (map[string]any, error) {
+				raw["configVersion"] = Version
+				return raw, nil
+			}},
+		),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, Version, cfg.ConfigVersion)
+	assert.Equal(t, "speakeasy", cfg.Generation.SDKClassName)
+	assert.Equal(t, []MigrationStep{
+		{From: "0.1.0", To: "0.2.0"},
+		{From: "0.2.0", To: Version},
+	}, cfg.MigrationHistory())
+}
+
+func TestLoad_WithDryRun_DoesNotPersist(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), testDir)
+
+	err := createTempFile(testDir, "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	genYamlPath := filepath.Join(dir, "gen.yaml")
+
+	_, err = Load(dir,
+		WithUpgradeFunc(testUpdateLang),
+		WithLanguages("go"),
+		WithDryRun(true),
+	)
+	require.NoError(t, err)
+
+	_, err = os.Stat(genYamlPath)
+	assert.True(t, os.IsNotExist(err), "expected dry run not to create %s", genYamlPath)
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Save writes c to dir/gen.yaml, creating dir if necessary. If c was loaded
+// by Load, the file's original node tree is patched in place so that
+// comments, key ordering, and blank lines survive - only the fields that
+// actually changed are rewritten. A Config that wasn't loaded from a file
+// (e.g. one built by newConfig) is encoded from scratch.
+func (c *Config) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating %s: %w", dir, err)
+	}
+
+	desired := &yaml.Node{}
+	if err := desired.Encode(c); err != nil {
+		return fmt.Errorf("encoding %s: %w", configFileName, err)
+	}
+
+	node := desired
+	if c.node != nil {
+		syncNode(c.node, desired)
+		node = c.node
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return fmt.Errorf("marshaling %s: %w", configFileName, err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("marshaling %s: %w", configFileName, err)
+	}
+
+	path := filepath.Join(dir, configFileName)
+	if err := writeFileAtomic(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+
+	c.node = node
+
+	return nil
+}
+
+// writeFileAtomic writes data to path by first writing it to a temp file in
+// the same directory, then renaming it into place, so a reader never sees a
+// partially written gen.yaml. If path already exists, its mode is carried
+// over to the replacement instead of being reset to perm, so a gen.yaml a
+// team has deliberately chmod'd (e.g. to keep it group-restricted) keeps
+// that mode across upgrades.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// syncNode mutates existing in place so its decoded value matches desired,
+// preserving existing's comments and style wherever the value underneath
+// didn't change. Keys present in existing but absent from desired are
+// dropped; keys present only in desired are appended.
+func syncNode(existing, desired *yaml.Node) {
+	if existing.Kind != desired.Kind {
+		*existing = *desired
+		return
+	}
+
+	switch existing.Kind {
+	case yaml.MappingNode:
+		syncMapping(existing, desired)
+	case yaml.ScalarNode:
+		if existing.Tag != desired.Tag || existing.Value != desired.Value {
+			existing.Tag = desired.Tag
+			existing.Value = desired.Value
+		}
+	default:
+		// Sequences and other node kinds aren't diffed field-by-field; a
+		// change anywhere in them just replaces the whole subtree.
+		var before, after any
+		if existing.Decode(&before) == nil && desired.Decode(&after) == nil && reflect.DeepEqual(before, after) {
+			return
+		}
+		*existing = *desired
+	}
+}
+
+// syncMapping applies syncNode's rules to a mapping's key/value pairs.
+func syncMapping(existing, desired *yaml.Node) {
+	indexOf := func(n *yaml.Node, key string) int {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == key {
+				return i
+			}
+		}
+		return -1
+	}
+
+	content := make([]*yaml.Node, 0, len(desired.Content))
+
+	for i := 0; i+1 < len(existing.Content); i += 2 {
+		key, value := existing.Content[i], existing.Content[i+1]
+
+		j := indexOf(desired, key.Value)
+		if j < 0 {
+			continue // removed
+		}
+
+		syncNode(value, desired.Content[j+1])
+		content = append(content, key, value)
+	}
+
+	for i := 0; i+1 < len(desired.Content); i += 2 {
+		if indexOf(existing, desired.Content[i].Value) >= 0 {
+			continue // already synced above
+		}
+		content = append(content, desired.Content[i], desired.Content[i+1])
+	}
+
+	existing.Content = content
+}
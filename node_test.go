@@ -0,0 +1,39 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Save_PreservesComments(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), testDir)
+
+	err := createTempFile(testDir, readTestFile(t, "commented-gen.yaml"))
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg, err := Load(dir, WithUpgradeFunc(testUpdateLang), WithLanguages("go"))
+	require.NoError(t, err)
+
+	lang := cfg.Languages["go"]
+	lang.Version = "1.4.0"
+	cfg.Languages["go"] = lang
+
+	require.NoError(t, cfg.Save(dir))
+
+	got, err := os.ReadFile(filepath.Join(dir, "gen.yaml"))
+	require.NoError(t, err)
+
+	want := strings.ReplaceAll(
+		readTestFile(t, "commented-gen.yaml"),
+		"version: 1.3.0 # pinned for the 1.x client line",
+		"version: 1.4.0 # pinned for the 1.x client line",
+	)
+
+	assert.Equal(t, want, string(got))
+}
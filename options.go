@@ -0,0 +1,101 @@
+package config
+
+import "os"
+
+// Option configures how Load behaves.
+type Option func(*loadOptions)
+
+// UpgradeFunc migrates a raw gen.yaml (decoded as a plain map) by one step.
+// It's the Apply func of a Migration, invoked when a loaded file's
+// configVersion matches that Migration's From.
+type UpgradeFunc func(raw map[string]any) (map[string]any, error)
+
+// EnvLookupFunc looks up an environment variable by name, returning its
+// value and whether it was found. It mirrors os.LookupEnv so tests can
+// inject a fake environment without touching process state.
+type EnvLookupFunc func(name string) (string, bool)
+
+type loadOptions struct {
+	languages       []string
+	migrations      []Migration
+	dryRun          bool
+	envExpansion    bool
+	envLookup       EnvLookupFunc
+	languageSchemas map[string]LanguageSchema
+}
+
+func newLoadOptions() *loadOptions {
+	return &loadOptions{
+		envLookup: os.LookupEnv,
+	}
+}
+
+// WithLanguages declares a language that should be present in the loaded
+// Config. Call it once per language. Languages not already in the loaded
+// file are added with defaultLanguageVersion and flagged in Config.New.
+func WithLanguages(lang string) Option {
+	return func(o *loadOptions) {
+		o.languages = append(o.languages, lang)
+	}
+}
+
+// WithUpgradeFunc is a convenience for registering a single migration from
+// a pre-v1.0.0 gen.yaml (one with no configVersion) straight to the current
+// Version, overriding the built-in default of that same step. For a
+// multi-step chain, use WithMigrations instead.
+func WithUpgradeFunc(f UpgradeFunc) Option {
+	return WithMigrations(Migration{From: "", To: Version, Apply: f})
+}
+
+// WithMigrations appends custom migrations to the chain Load walks to bring
+// a file up to the current Version. A migration whose From matches an
+// existing entry - including a default one - replaces it.
+func WithMigrations(migrations ...Migration) Option {
+	return func(o *loadOptions) {
+		o.migrations = append(o.migrations, migrations...)
+	}
+}
+
+// WithDryRun, when enabled, makes Load return the config it would have
+// written - migrated, schema-defaulted, with new languages applied -
+// without persisting it or creating gen.yaml if it doesn't yet exist.
+func WithDryRun(enabled bool) Option {
+	return func(o *loadOptions) {
+		o.dryRun = enabled
+	}
+}
+
+// WithEnvExpansion enables resolution of ${ENV_VAR}, ${ENV_VAR:-default},
+// and $ENV_ prefixed references in gen.yaml string values. Expansion is
+// applied only to the Config returned from Load, after that Config (with
+// its references intact) has already been persisted, so Load itself never
+// writes resolved values to gen.yaml; a later Save of the returned Config
+// writes them as resolved. It's
+// disabled by default so that literal dollar signs in existing configs
+// keep round-tripping unchanged.
+func WithEnvExpansion(enabled bool) Option {
+	return func(o *loadOptions) {
+		o.envExpansion = enabled
+	}
+}
+
+// WithEnvLookup overrides the lookup used to resolve environment variable
+// references when env expansion is enabled. It defaults to os.LookupEnv.
+func WithEnvLookup(lookup EnvLookupFunc) Option {
+	return func(o *loadOptions) {
+		o.envLookup = lookup
+	}
+}
+
+// WithLanguageSchema registers schema for lang for the duration of this
+// Load call only, taking precedence over anything registered process-wide
+// with RegisterLanguage. It lets a caller validate a language this module
+// doesn't ship a built-in schema for without forking it.
+func WithLanguageSchema(lang string, schema LanguageSchema) Option {
+	return func(o *loadOptions) {
+		if o.languageSchemas == nil {
+			o.languageSchemas = map[string]LanguageSchema{}
+		}
+		o.languageSchemas[lang] = schema
+	}
+}
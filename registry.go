@@ -0,0 +1,31 @@
+package config
+
+import "sync"
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]LanguageSchema{}
+)
+
+// RegisterLanguage registers schema as the validation and defaulting
+// contract for lang's LanguageConfig.Cfg. A later registration for the same
+// lang replaces the earlier one. External generators should call this from
+// an init func so their schema is available without forking this module.
+func RegisterLanguage(lang string, schema LanguageSchema) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[lang] = schema
+}
+
+// lookupSchema resolves lang's schema, preferring a per-Load override from
+// WithLanguageSchema over the process-wide registry.
+func lookupSchema(lang string, overrides map[string]LanguageSchema) (LanguageSchema, bool) {
+	if s, ok := overrides[lang]; ok {
+		return s, true
+	}
+
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	s, ok := registry[lang]
+	return s, ok
+}
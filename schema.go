@@ -0,0 +1,92 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errRequired is the error wrapped by a SchemaError for a missing required
+// field.
+var errRequired = errors.New("required")
+
+// LanguageField describes one key a LanguageConfig.Cfg is expected to hold.
+type LanguageField struct {
+	// Name is the Cfg key this field validates, e.g. "packageName".
+	Name string
+	// Required marks the field as mandatory once a language is no longer
+	// newly scaffolded. It's ignored for languages Load has just added.
+	Required bool
+	// Default, when non-nil, is written into Cfg if the key is absent.
+	Default any
+	// Validate, when set, is run against the field's value if present.
+	Validate func(value any) error
+}
+
+// LanguageSchema declares the shape of a language's LanguageConfig.Cfg: its
+// known keys, which are required, what defaults apply, and how each value
+// is validated. Register one with RegisterLanguage so config.Load can
+// enforce it, or hand one to WithLanguageSchema for a single Load call.
+type LanguageSchema struct {
+	Fields []LanguageField
+}
+
+// SchemaError reports a single LanguageSchema violation, identified by its
+// dotted path (e.g. "go.packageName").
+type SchemaError struct {
+	Path string
+	Err  error
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Err)
+}
+
+func (e *SchemaError) Unwrap() error {
+	return e.Err
+}
+
+// withoutRequired returns a copy of s with every field's Required cleared,
+// used while validating a language Load has just scaffolded and that the
+// user hasn't had a chance to configure yet.
+func (s LanguageSchema) withoutRequired() LanguageSchema {
+	fields := make([]LanguageField, len(s.Fields))
+	for i, f := range s.Fields {
+		f.Required = false
+		fields[i] = f
+	}
+	return LanguageSchema{Fields: fields}
+}
+
+// Apply validates cfg against s, returning cfg with defaults filled in for
+// any missing key whose field has a Default, required or not, in which case
+// no required error is reported for it (a nil cfg is allocated lazily if a
+// default needs to be written) along with every violation found - it
+// doesn't stop at the first.
+func (s LanguageSchema) Apply(lang string, cfg map[string]any) (map[string]any, []error) {
+	var errs []error
+
+	for _, field := range s.Fields {
+		value, ok := cfg[field.Name]
+		if !ok {
+			if field.Default != nil {
+				if cfg == nil {
+					cfg = map[string]any{}
+				}
+				cfg[field.Name] = field.Default
+				continue
+			}
+			if field.Required {
+				errs = append(errs, &SchemaError{Path: lang + "." + field.Name, Err: errRequired})
+			}
+			continue
+		}
+
+		if field.Validate != nil {
+			if err := field.Validate(value); err != nil {
+				errs = append(errs, &SchemaError{Path: lang + "." + field.Name, Err: err})
+			}
+		}
+	}
+
+	return cfg, errs
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+func init() {
+	RegisterLanguage("go", LanguageSchema{
+		Fields: []LanguageField{
+			{
+				Name:     "packageName",
+				Required: true,
+				Validate: validateNonEmptyString,
+			},
+		},
+	})
+
+	RegisterLanguage("typescript", LanguageSchema{
+		Fields: []LanguageField{
+			{
+				Name:     "packageName",
+				Required: true,
+				Validate: validateNonEmptyString,
+			},
+		},
+	})
+}
+
+func validateNonEmptyString(value any) error {
+	s, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", value)
+	}
+	if s == "" {
+		return errors.New("must not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pythonSchema() LanguageSchema {
+	return LanguageSchema{
+		Fields: []LanguageField{
+			{
+				Name:     "packageName",
+				Required: true,
+				Validate: validateNonEmptyString,
+			},
+			{
+				Name:    "license",
+				Default: "MIT",
+			},
+		},
+	}
+}
+
+func TestLoad_LanguageSchema_MissingRequiredField(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), testDir)
+
+	err := createTempFile(testDir, readTestFile(t, "schema-missing-gen.yaml"))
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = Load(dir,
+		WithUpgradeFunc(testUpdateLang),
+		WithLanguages("python"),
+		WithLanguageSchema("python", pythonSchema()),
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "python.packageName")
+}
+
+func TestLoad_LanguageSchema_AppliesDefaults(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), testDir)
+
+	err := createTempFile(testDir, readTestFile(t, "schema-defaults-gen.yaml"))
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg, err := Load(dir,
+		WithUpgradeFunc(testUpdateLang),
+		WithLanguages("python"),
+		WithLanguageSchema("python", pythonSchema()),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"packageName": "widgets",
+		"license":     "MIT",
+	}, cfg.Languages["python"].Cfg)
+}
+
+func TestLoad_LanguageSchema_SkipsRequiredForNewLanguage(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), testDir)
+	defer os.RemoveAll(dir)
+
+	cfg, err := Load(dir,
+		WithUpgradeFunc(testUpdateLang),
+		WithLanguages("python"),
+		WithLanguageSchema("python", pythonSchema()),
+	)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.New["python"])
+	assert.Equal(t, map[string]any{"license": "MIT"}, cfg.Languages["python"].Cfg)
+}
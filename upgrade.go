@@ -0,0 +1,9 @@
+package config
+
+// defaultUpgradeFunc is used when no UpgradeFunc option is supplied. It
+// performs the minimal upgrade of stamping the current Version onto a
+// pre-v1.0.0 file, leaving everything else untouched.
+func defaultUpgradeFunc(raw map[string]any) (map[string]any, error) {
+	raw["configVersion"] = Version
+	return raw, nil
+}
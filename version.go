@@ -0,0 +1,13 @@
+package config
+
+// Version is the current gen.yaml schema version. It is written to newly
+// created config files and compared against a loaded file's configVersion
+// to decide whether an upgrade is required.
+const Version = "1.0.0"
+
+// defaultLanguageVersion is the generator version recorded for a language
+// the first time it's added to a config, before any generation has run.
+const defaultLanguageVersion = "0.0.1"
+
+// configFileName is the name of the config file within a target directory.
+const configFileName = "gen.yaml"